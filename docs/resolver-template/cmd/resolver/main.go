@@ -0,0 +1,220 @@
+/*
+ Copyright 2024 The Tekton Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/remoteresolution/resolver/framework"
+	resolutioncommon "github.com/tektoncd/pipeline/pkg/resolution/common"
+	"knative.dev/pkg/injection/sharedmain"
+)
+
+const (
+	labelValueDemoResolverType = "demo"
+
+	// urlParamName is the name of the param this resolver expects the
+	// requested URL to be carried in. It is the only param the demo
+	// resolver accepts.
+	urlParamName = "url"
+
+	// demoScheme is the URL scheme handled by fetchDemoPipeline, the
+	// only scheme this resolver registers out of the box.
+	demoScheme = "demoscheme"
+)
+
+// pipeline is the canned Pipeline YAML the demo resolver returns for a
+// successful resolution.
+const pipeline = `
+kind: Pipeline
+apiVersion: tekton.dev/v1
+metadata:
+  name: pipeline
+spec:
+  tasks:
+    - name: task
+      taskSpec:
+        steps:
+          - name: step
+            image: alpine:3.19
+            script: |
+              echo "hello world"
+`
+
+// schemeHandlerFunc fetches and returns the resolved resource for a URL
+// whose scheme has been dispatched to it.
+type schemeHandlerFunc func(ctx context.Context, u *url.URL) (resolutioncommon.ResolvedResource, error)
+
+// ErrorInvalidScheme is returned when a request's URL scheme does not
+// match one of the schemes registered with the resolver, so callers
+// can distinguish it from other validation failures.
+type ErrorInvalidScheme struct {
+	Want []string
+	Got  string
+}
+
+func (e *ErrorInvalidScheme) Error() string {
+	return fmt.Sprintf("Invalid Scheme. Want %s, Got %s", strings.Join(e.Want, " or "), e.Got)
+}
+
+var _ framework.Resolver = &resolver{}
+
+// resolver implements a demo remote resolver that resolves one or more
+// configured URL schemes to a canned Pipeline, dispatching each scheme
+// to its own fetch backend. The zero value registers demoScheme, so
+// &resolver{} remains a valid, ready-to-use resolver.
+type resolver struct {
+	schemes  []string
+	handlers map[string]schemeHandlerFunc
+}
+
+// registerScheme associates a URL scheme with the handler that should
+// fetch resources requested through it.
+func (r *resolver) registerScheme(scheme string, handler schemeHandlerFunc) {
+	if r.handlers == nil {
+		r.handlers = map[string]schemeHandlerFunc{}
+	}
+	r.schemes = append(r.schemes, scheme)
+	r.handlers[scheme] = handler
+}
+
+// ensureDefaults registers the resolver's default scheme if it isn't
+// already present, so a bare &resolver{} behaves the same as one built
+// through registerScheme, even after other schemes have been
+// registered on it directly.
+func (r *resolver) ensureDefaults() {
+	if r.handlers == nil {
+		r.handlers = map[string]schemeHandlerFunc{}
+	}
+	if _, ok := r.handlers[demoScheme]; !ok {
+		r.schemes = append([]string{demoScheme}, r.schemes...)
+		r.handlers[demoScheme] = r.fetchDemoPipeline
+	}
+}
+
+// Schemes returns the URL schemes this resolver instance knows how to
+// dispatch.
+func (r *resolver) Schemes() []string {
+	r.ensureDefaults()
+	return r.schemes
+}
+
+// Initialize is called once at startup.
+func (r *resolver) Initialize(context.Context) error {
+	return nil
+}
+
+// GetName returns a string name to refer to this resolver by.
+func (r *resolver) GetName(context.Context) string {
+	return "Demo"
+}
+
+// GetSelector returns the labels that are used to direct requests to
+// this resolver.
+func (r *resolver) GetSelector(context.Context) map[string]string {
+	return map[string]string{
+		resolutioncommon.LabelKeyResolverType: labelValueDemoResolverType,
+	}
+}
+
+// ValidateParams returns an error if the given parameters do not carry
+// a URL whose scheme this resolver knows how to handle.
+func (r *resolver) ValidateParams(ctx context.Context, params []pipelinev1.Param) error {
+	_, _, err := r.schemeAndURL(params)
+	return err
+}
+
+// Resolve fetches and returns the resolved content from the URL
+// carried in the given parameters, dispatching to the handler
+// registered for its scheme.
+func (r *resolver) Resolve(ctx context.Context, params []pipelinev1.Param) (resolutioncommon.ResolvedResource, error) {
+	u, handler, err := r.schemeAndURL(params)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, u)
+}
+
+// schemeAndURL extracts the requested URL from params, validates it,
+// and returns the handler registered for its scheme.
+func (r *resolver) schemeAndURL(params []pipelinev1.Param) (*url.URL, schemeHandlerFunc, error) {
+	r.ensureDefaults()
+	raw, err := getURLParam(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	if u.Scheme == "" {
+		return nil, nil, fmt.Errorf("parse %q: invalid URI for request", raw)
+	}
+	handler, ok := r.handlers[u.Scheme]
+	if !ok {
+		return nil, nil, &ErrorInvalidScheme{Want: r.schemes, Got: u.Scheme}
+	}
+	return u, handler, nil
+}
+
+// getURLParam returns the value of the single "url" param this
+// resolver accepts, or an error if params does not consist of exactly
+// that one param.
+func getURLParam(params []pipelinev1.Param) (string, error) {
+	if len(params) != 1 || params[0].Name != urlParamName {
+		return "", errors.New("no params allowed")
+	}
+	return params[0].Value.StringVal, nil
+}
+
+// fetchDemoPipeline is the scheme handler registered for demoScheme. It
+// ignores the requested URL and always returns the canned pipeline.
+func (r *resolver) fetchDemoPipeline(ctx context.Context, u *url.URL) (resolutioncommon.ResolvedResource, error) {
+	return &demoResolvedResource{data: []byte(pipeline)}, nil
+}
+
+var _ resolutioncommon.ResolvedResource = &demoResolvedResource{}
+
+// demoResolvedResource is the ResolvedResource returned by this
+// resolver's scheme handlers.
+type demoResolvedResource struct {
+	data []byte
+}
+
+// Data returns the resolved bytes.
+func (r *demoResolvedResource) Data() ([]byte, error) {
+	return r.data, nil
+}
+
+// Annotations returns nil; the demo resolver attaches none.
+func (r *demoResolvedResource) Annotations() map[string]string {
+	return nil
+}
+
+// RefSource returns nil; the demo resolver does not track provenance.
+func (r *demoResolvedResource) RefSource() *pipelinev1.RefSource {
+	return nil
+}
+
+func main() {
+	sharedmain.Main("controller", framework.NewController(context.Background(), &resolver{}))
+}
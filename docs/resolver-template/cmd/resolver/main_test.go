@@ -17,8 +17,10 @@
 package main
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
+	"net/url"
 	"testing"
 	"time"
 
@@ -205,3 +207,61 @@ func TestResolver_Failure_InvalidParams(t *testing.T) {
 	expectedErr := errors.New(`invalid resource request "foo/rr": no params allowed`)
 	frtesting.RunResolverReconcileTest(ctx, t, d, r, request, expectedStatus, expectedErr)
 }
+
+func TestResolver_SchemeDispatch(t *testing.T) {
+	const altScheme = "demoscheme2"
+	const altPipeline = "kind: Pipeline\nmetadata:\n  name: alt-pipeline\n"
+
+	r := &resolver{}
+	r.registerScheme(altScheme, func(ctx context.Context, u *url.URL) (resolutioncommon.ResolvedResource, error) {
+		return &demoResolvedResource{data: []byte(altPipeline)}, nil
+	})
+
+	for _, tc := range []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "default scheme", url: "demoscheme://foo/bar", want: pipeline},
+		{name: "registered alternate scheme", url: altScheme + "://foo/bar", want: altPipeline},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			params := []pipelinev1.Param{{Name: urlParamName, Value: *pipelinev1.NewStructuredValues(tc.url)}}
+
+			if err := r.ValidateParams(context.Background(), params); err != nil {
+				t.Fatalf("ValidateParams() = %v, want no error", err)
+			}
+
+			resolved, err := r.Resolve(context.Background(), params)
+			if err != nil {
+				t.Fatalf("Resolve() = %v, want no error", err)
+			}
+			data, err := resolved.Data()
+			if err != nil {
+				t.Fatalf("Data() = %v, want no error", err)
+			}
+			if string(data) != tc.want {
+				t.Errorf("Data() = %q, want %q", data, tc.want)
+			}
+		})
+	}
+}
+
+func TestResolver_Failure_UnknownScheme(t *testing.T) {
+	r := &resolver{}
+	r.registerScheme("demoscheme2", func(ctx context.Context, u *url.URL) (resolutioncommon.ResolvedResource, error) {
+		return &demoResolvedResource{data: []byte(pipeline)}, nil
+	})
+
+	params := []pipelinev1.Param{{Name: urlParamName, Value: *pipelinev1.NewStructuredValues("otherscheme://foo/bar")}}
+
+	wantErr := &ErrorInvalidScheme{Want: []string{"demoscheme", "demoscheme2"}, Got: "otherscheme"}
+
+	if err := r.ValidateParams(context.Background(), params); err == nil || err.Error() != wantErr.Error() {
+		t.Errorf("ValidateParams() = %v, want %v", err, wantErr)
+	}
+
+	if _, err := r.Resolve(context.Background(), params); err == nil || err.Error() != wantErr.Error() {
+		t.Errorf("Resolve() = %v, want %v", err, wantErr)
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2018 The Knative Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"github.com/knative/build-pipeline/pkg/apis/pipeline/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ContainerOp is an operation which modify a Container struct.
+type ContainerOp func(*corev1.Container)
+
+// ResourcesOp is an operation which modify a ResourceRequirements struct.
+type ResourcesOp func(*corev1.ResourceRequirements)
+
+// VolumeOp is an operation which modify a Volume struct.
+type VolumeOp func(*corev1.Volume)
+
+// Command sets the command of the Container.
+func Command(args ...string) ContainerOp {
+	return func(c *corev1.Container) {
+		c.Command = args
+	}
+}
+
+// Args sets the args of the Container.
+func Args(args ...string) ContainerOp {
+	return func(c *corev1.Container) {
+		c.Args = args
+	}
+}
+
+// WorkingDir sets the workingDir of the Container.
+func WorkingDir(dir string) ContainerOp {
+	return func(c *corev1.Container) {
+		c.WorkingDir = dir
+	}
+}
+
+// Privileged sets the Container's SecurityContext to run as privileged.
+func Privileged() ContainerOp {
+	return func(c *corev1.Container) {
+		if c.SecurityContext == nil {
+			c.SecurityContext = &corev1.SecurityContext{}
+		}
+		privileged := true
+		c.SecurityContext.Privileged = &privileged
+	}
+}
+
+// VolumeMount adds a VolumeMount, with specified name and mount path, to the Container.
+func VolumeMount(name, mountPath string) ContainerOp {
+	return func(c *corev1.Container) {
+		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: mountPath,
+		})
+	}
+}
+
+// EnvVar adds an environment variable, with specified name and value, to the Container.
+func EnvVar(name, value string) ContainerOp {
+	return func(c *corev1.Container) {
+		c.Env = append(c.Env, corev1.EnvVar{Name: name, Value: value})
+	}
+}
+
+// EnvFromSecret adds an environment variable sourced from a secret key to the Container.
+func EnvFromSecret(name, secretName, secretKey string) ContainerOp {
+	return func(c *corev1.Container) {
+		c.Env = append(c.Env, corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  secretKey,
+				},
+			},
+		})
+	}
+}
+
+// EnvFromConfigMap adds an environment variable sourced from a ConfigMap key to the Container.
+func EnvFromConfigMap(name, configMapName, configMapKey string) ContainerOp {
+	return func(c *corev1.Container) {
+		c.Env = append(c.Env, corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+					Key:                  configMapKey,
+				},
+			},
+		})
+	}
+}
+
+// Resources sets the resource requirements of the Container.
+// Any number of ResourcesOp modifier can be passed to transform it.
+func Resources(ops ...ResourcesOp) ContainerOp {
+	return func(c *corev1.Container) {
+		r := &c.Resources
+		for _, op := range ops {
+			op(r)
+		}
+		c.Resources = *r
+	}
+}
+
+// Requests sets the cpu and/or memory resource requests on a ResourceRequirements.
+// Pass an empty string to leave that resource's request unset.
+func Requests(cpu, memory string) ResourcesOp {
+	return func(r *corev1.ResourceRequirements) {
+		if r.Requests == nil {
+			r.Requests = corev1.ResourceList{}
+		}
+		if cpu != "" {
+			r.Requests[corev1.ResourceCPU] = resource.MustParse(cpu)
+		}
+		if memory != "" {
+			r.Requests[corev1.ResourceMemory] = resource.MustParse(memory)
+		}
+	}
+}
+
+// Limits sets the cpu and/or memory resource limits on a ResourceRequirements.
+// Pass an empty string to leave that resource's limit unset.
+func Limits(cpu, memory string) ResourcesOp {
+	return func(r *corev1.ResourceRequirements) {
+		if r.Limits == nil {
+			r.Limits = corev1.ResourceList{}
+		}
+		if cpu != "" {
+			r.Limits[corev1.ResourceCPU] = resource.MustParse(cpu)
+		}
+		if memory != "" {
+			r.Limits[corev1.ResourceMemory] = resource.MustParse(memory)
+		}
+	}
+}
+
+// Sidecar adds a sidecar container, with specified name and image, to the TaskSpec.
+// Any number of ContainerOp modifier can be passed to transform it.
+func Sidecar(name, image string, ops ...ContainerOp) TaskSpecOp {
+	return func(spec *v1alpha1.TaskSpec) {
+		c := &corev1.Container{Name: name, Image: image}
+		for _, op := range ops {
+			op(c)
+		}
+		spec.Sidecars = append(spec.Sidecars, *c)
+	}
+}
+
+// Volume adds a Volume, with specified name, to the TaskSpec.
+// Any number of VolumeOp modifier can be passed to transform it.
+func Volume(name string, ops ...VolumeOp) TaskSpecOp {
+	return func(spec *v1alpha1.TaskSpec) {
+		v := &corev1.Volume{Name: name}
+		for _, op := range ops {
+			op(v)
+		}
+		spec.Volumes = append(spec.Volumes, *v)
+	}
+}
+
+// VolumeEmptyDir sets an EmptyDir source on the Volume.
+func VolumeEmptyDir() VolumeOp {
+	return func(v *corev1.Volume) {
+		v.VolumeSource = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+	}
+}
+
+// VolumeHostPath sets a HostPath source, with specified path, on the Volume.
+func VolumeHostPath(path string) VolumeOp {
+	return func(v *corev1.Volume) {
+		v.VolumeSource = corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: path}}
+	}
+}
+
+// VolumeSecret sets a Secret source, with specified secret name, on the Volume.
+func VolumeSecret(secretName string) VolumeOp {
+	return func(v *corev1.Volume) {
+		v.VolumeSource = corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secretName}}
+	}
+}
+
+// VolumeConfigMap sets a ConfigMap source, with specified ConfigMap name, on the Volume.
+func VolumeConfigMap(name string) VolumeOp {
+	return func(v *corev1.Volume) {
+		v.VolumeSource = corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			},
+		}
+	}
+}
+
+// VolumeClaim sets a PersistentVolumeClaim source, with specified claim name, on the Volume.
+func VolumeClaim(claimName string) VolumeOp {
+	return func(v *corev1.Volume) {
+		v.VolumeSource = corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+		}
+	}
+}
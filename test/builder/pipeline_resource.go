@@ -0,0 +1,117 @@
+/*
+Copyright 2018 The Knative Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+
+	"github.com/knative/build-pipeline/pkg/apis/pipeline/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PipelineResourceOp is an operation which modify a PipelineResource struct.
+type PipelineResourceOp func(*v1alpha1.PipelineResource)
+
+// PipelineResourceSpecOp is an operation which modify a PipelineResourceSpec struct.
+type PipelineResourceSpecOp func(*v1alpha1.PipelineResourceSpec)
+
+// requiredParamsByType lists the resource params each PipelineResourceType
+// requires, used by Verify to catch a builder that forgot one.
+var requiredParamsByType = map[v1alpha1.PipelineResourceType][]string{
+	v1alpha1.PipelineResourceTypeGit:     {"url"},
+	v1alpha1.PipelineResourceTypeImage:   {"url"},
+	v1alpha1.PipelineResourceTypeCluster: {"url", "cadata", "token"},
+	v1alpha1.PipelineResourceTypeStorage: {"type", "location"},
+}
+
+// PipelineResource creates a PipelineResource with default values.
+// Any number of PipelineResource modifier can be passed to transform it.
+func PipelineResource(name, namespace string, ops ...PipelineResourceOp) *v1alpha1.PipelineResource {
+	r := &v1alpha1.PipelineResource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, op := range ops {
+		op(r)
+	}
+	return r
+}
+
+// PipelineResourceSpec sets the specified spec of the PipelineResource.
+// Any number of PipelineResourceSpecOp modifier can be passed to transform it.
+func PipelineResourceSpec(ops ...PipelineResourceSpecOp) PipelineResourceOp {
+	return func(r *v1alpha1.PipelineResource) {
+		spec := &r.Spec
+		for _, op := range ops {
+			op(spec)
+		}
+		r.Spec = *spec
+	}
+}
+
+// PipelineResourceType sets the type of the PipelineResourceSpec.
+func PipelineResourceType(resourceType v1alpha1.PipelineResourceType) PipelineResourceSpecOp {
+	return func(spec *v1alpha1.PipelineResourceSpec) {
+		spec.Type = resourceType
+	}
+}
+
+// PipelineResourceParam adds a ResourceParam, with specified name and value, to the PipelineResourceSpec.
+func PipelineResourceParam(name, value string) PipelineResourceSpecOp {
+	return func(spec *v1alpha1.PipelineResourceSpec) {
+		spec.Params = append(spec.Params, v1alpha1.ResourceParam{Name: name, Value: value})
+	}
+}
+
+// PipelineResourceSecretParam adds a SecretParam, with specified field name,
+// secret name and secret key, to the PipelineResourceSpec.
+func PipelineResourceSecretParam(fieldName, secretName, secretKey string) PipelineResourceSpecOp {
+	return func(spec *v1alpha1.PipelineResourceSpec) {
+		spec.SecretParams = append(spec.SecretParams, v1alpha1.SecretParam{
+			FieldName:  fieldName,
+			SecretName: secretName,
+			SecretKey:  secretKey,
+		})
+	}
+}
+
+// Verify panics if the PipelineResource is missing a param required by its
+// type. Pass it last to PipelineResource so it validates the fully built
+// spec, e.g. PipelineResource("git-resource", "foo", PipelineResourceSpec(...), Verify()).
+func Verify() PipelineResourceOp {
+	return func(r *v1alpha1.PipelineResource) {
+		required, ok := requiredParamsByType[r.Spec.Type]
+		if !ok {
+			return
+		}
+		have := map[string]bool{}
+		for _, p := range r.Spec.Params {
+			have[p.Name] = true
+		}
+		for _, p := range r.Spec.SecretParams {
+			have[p.FieldName] = true
+		}
+		var missing []string
+		for _, name := range required {
+			if !have[name] {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			panic(fmt.Sprintf("PipelineResource %q of type %q is missing required params: %v", r.Name, r.Spec.Type, missing))
+		}
+	}
+}
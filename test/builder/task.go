@@ -14,6 +14,8 @@ limitations under the License.
 package builder
 
 import (
+	"time"
+
 	"github.com/knative/build-pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/knative/build-pipeline/pkg/reconciler/v1alpha1/taskrun/resources"
 	duckv1alpha1 "github.com/knative/pkg/apis/duck/v1alpha1"
@@ -256,6 +258,20 @@ func Condition(condition duckv1alpha1.Condition) TaskRunStatusOp {
 	}
 }
 
+// TaskRunStartTime sets the start time to the TaskRunStatus.
+func TaskRunStartTime(t time.Time) TaskRunStatusOp {
+	return func(s *v1alpha1.TaskRunStatus) {
+		s.StartTime = &metav1.Time{Time: t}
+	}
+}
+
+// TaskRunCompletionTime sets the completion time to the TaskRunStatus.
+func TaskRunCompletionTime(t time.Time) TaskRunStatusOp {
+	return func(s *v1alpha1.TaskRunStatus) {
+		s.CompletionTime = &metav1.Time{Time: t}
+	}
+}
+
 // StepState adds a StepState to the TaskRunStatus.
 func StepState(ops ...StepStateOp) TaskRunStatusOp {
 	return func(s *v1alpha1.TaskRunStatus) {
@@ -276,6 +292,24 @@ func StateTerminated(exitcode int) StepStateOp {
 	}
 }
 
+// StepStateWaiting set Waiting, with specified reason and message, to the StepState.
+func StepStateWaiting(reason, msg string) StepStateOp {
+	return func(s *v1alpha1.StepState) {
+		s.ContainerState = corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: reason, Message: msg},
+		}
+	}
+}
+
+// StepStateRunning set Running, with specified start time, to the StepState.
+func StepStateRunning(startedAt time.Time) StepStateOp {
+	return func(s *v1alpha1.StepState) {
+		s.ContainerState = corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(startedAt)},
+		}
+	}
+}
+
 // TaskRunOwnerReference sets the OwnerReference, with specified kind and name, to the TaskRun.
 func TaskRunOwnerReference(kind, name string, ops ...OwnerReferenceOp) TaskRunOp {
 	return func(tr *v1alpha1.TaskRun) {
@@ -383,6 +417,35 @@ func TaskRunServiceAccount(sa string) TaskRunSpecOp {
 	}
 }
 
+// TaskRunTimeout sets the timeout to the TaskRunSpec.
+func TaskRunTimeout(d time.Duration) TaskRunSpecOp {
+	return func(trs *v1alpha1.TaskRunSpec) {
+		trs.Timeout = &metav1.Duration{Duration: d}
+	}
+}
+
+// TaskRunCancelled sets the TaskRunSpec's status to indicate that the
+// TaskRun should be cancelled.
+func TaskRunCancelled() TaskRunSpecOp {
+	return func(trs *v1alpha1.TaskRunSpec) {
+		trs.Status = v1alpha1.TaskRunSpecStatusCancelled
+	}
+}
+
+// TaskRunNodeSelector sets the nodeSelector to the TaskRunSpec.
+func TaskRunNodeSelector(nodeSelector map[string]string) TaskRunSpecOp {
+	return func(trs *v1alpha1.TaskRunSpec) {
+		trs.NodeSelector = nodeSelector
+	}
+}
+
+// TaskRunSpecStatus sets the specified status to the TaskRunSpec.
+func TaskRunSpecStatus(status v1alpha1.TaskRunSpecStatus) TaskRunSpecOp {
+	return func(trs *v1alpha1.TaskRunSpec) {
+		trs.Status = status
+	}
+}
+
 // TaskRunInputs sets inputs to the TaskRunSpec.
 // Any number of TaskRunInputs modifier can be passed to transform it.
 func TaskRunInputs(ops ...TaskRunInputsOp) TaskRunSpecOp {
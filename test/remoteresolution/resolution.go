@@ -44,59 +44,170 @@ func NewResolvedResource(data []byte, annotations map[string]string, source *pip
 	}
 }
 
+// MatchMode controls how Requester.Submit matches an incoming request
+// against the resource/error pairs registered on the Requester.
+type MatchMode int
+
+const (
+	// matchAny returns the first registered resource/error for every
+	// Submit call, regardless of resolver payload. This is the
+	// default mode, matching the Requester's historical behaviour.
+	matchAny MatchMode = iota
+	// matchStrict only returns a registered resource/error when the
+	// incoming request's ResolverPayload matches one registered via
+	// WithResponse; any other request fails with an
+	// error. Use this to assert that no request is made, or that only
+	// requests matching specific payloads succeed.
+	matchStrict
+)
+
+// RequesterOp is an operation which modifies a Requester.
+type RequesterOp func(*Requester)
+
+// StrictMatch configures the Requester to only resolve Submit calls
+// whose ResolverPayload matches one registered via WithResponse. The
+// resource and error passed to NewRequester are not registered in this
+// mode.
+func StrictMatch() RequesterOp {
+	return func(r *Requester) {
+		r.matchMode = matchStrict
+	}
+}
+
+// AnyMatch configures the Requester to resolve every Submit call with
+// the first registered resource/error, regardless of payload. This is
+// the default.
+func AnyMatch() RequesterOp {
+	return func(r *Requester) {
+		r.matchMode = matchAny
+	}
+}
+
+// Recorder configures the Requester to record every Submit call into
+// an inspectable slice, retrievable via Requester.SubmitCalls, so tests
+// can assert the exact sequence, count, and content of resolution
+// requests made by the controller under test.
+func Recorder() RequesterOp {
+	return func(r *Requester) {
+		r.record = true
+	}
+}
+
+// WithResponse registers an additional resolverPayload -> resource|err
+// pair on the Requester, for StrictMatch scenarios that resolve
+// several distinct refs in one reconcile.
+func WithResponse(resolverPayload resource.ResolverPayload, resolvedResource resolution.ResolvedResource, err error) RequesterOp {
+	return func(r *Requester) {
+		r.responses = append(r.responses, submitResponse{
+			resolverPayload:  resolverPayload,
+			resolvedResource: resolvedResource,
+			submitErr:        err,
+		})
+	}
+}
+
 // NewRequester creates a mock requester that resolves to the given
-// resource or returns the given error on Submit().
-func NewRequester(resource resolution.ResolvedResource, err error, resolverPayload resource.ResolverPayload) *Requester {
-	return &Requester{
-		ResolvedResource: resource,
-		SubmitErr:        err,
-		ResolverPayload:  resolverPayload,
+// resource or returns the given error on Submit(). By default it
+// matches any request; pass StrictMatch() to require the request's
+// ResolverPayload to match one registered via WithResponse, and
+// Recorder() to record every Submit call for later inspection.
+//
+// In StrictMatch mode, resource and err are not registered as a
+// catch-all: only pairs registered via WithResponse are matched, so
+// tests can assert that no request is made, or that only specific
+// payloads resolve successfully.
+func NewRequester(resource resolution.ResolvedResource, err error, ops ...RequesterOp) *Requester {
+	r := &Requester{}
+	for _, op := range ops {
+		op(r)
 	}
+	if r.matchMode != matchStrict {
+		r.responses = append([]submitResponse{{resolvedResource: resource, submitErr: err}}, r.responses...)
+	}
+	return r
+}
+
+// submitResponse pairs a ResolverPayload with the resource/error a
+// Requester should return for a Submit call matching it.
+type submitResponse struct {
+	resolverPayload  resource.ResolverPayload
+	resolvedResource resolution.ResolvedResource
+	submitErr        error
+}
+
+// SubmitCall records a single call made to Requester.Submit.
+type SubmitCall struct {
+	ResolverName    resolution.ResolverName
+	ResolverPayload resource.ResolverPayload
 }
 
 // Requester implements resolution.Requester and makes it easier
 // to mock the outcome of a remote pipelineRef or taskRef resolution.
 type Requester struct {
-	// The resolved resource object to return when a request is
-	// submitted.
-	ResolvedResource resolution.ResolvedResource
-	// An error to return when a request is submitted.
-	SubmitErr error
-	// ResolverPayload that should match that of the request in order to return the resolved resource
-	ResolverPayload resource.ResolverPayload
+	matchMode MatchMode
+	responses []submitResponse
+
+	record      bool
+	submitCalls []SubmitCall
+}
+
+// SubmitCalls returns every Submit call recorded by this Requester, in
+// the order they were made. Only populated when Recorder() was passed
+// to NewRequester.
+func (r *Requester) SubmitCalls() []SubmitCall {
+	return r.submitCalls
 }
 
 // Submit implements resolution.Requester, accepting the name of a
 // resolver and a request for a specific remote file, and then returns
 // whatever mock data was provided on initialization.
 func (r *Requester) Submit(ctx context.Context, resolverName resolution.ResolverName, req resource.Request) (resolution.ResolvedResource, error) {
-	if (r.ResolverPayload == resource.ResolverPayload{} || r.ResolverPayload.ResolutionSpec == nil || len(r.ResolverPayload.ResolutionSpec.Params) == 0) {
-		return r.ResolvedResource, r.SubmitErr
+	payload := req.ResolverPayload()
+	if r.record {
+		r.submitCalls = append(r.submitCalls, SubmitCall{ResolverName: resolverName, ResolverPayload: payload})
 	}
-	if r.ResolverPayload.ResolutionSpec.URL == "" {
-		return r.ResolvedResource, r.SubmitErr
+
+	if r.matchMode == matchAny {
+		resp := r.responses[0]
+		return resp.resolvedResource, resp.submitErr
 	}
-	reqParams := make(map[string]pipelinev1.ParamValue)
-	for _, p := range req.ResolverPayload().ResolutionSpec.Params {
-		reqParams[p.Name] = p.Value
+
+	for _, resp := range r.responses {
+		if err := matchResolverPayload(resp.resolverPayload, payload); err == nil {
+			return resp.resolvedResource, resp.submitErr
+		}
+	}
+	return nil, fmt.Errorf("no response registered for resolver payload %#v", payload)
+}
+
+// matchResolverPayload returns nil if got matches want, or an error
+// describing every mismatched param, or else the mismatched URL.
+func matchResolverPayload(want, got resource.ResolverPayload) error {
+	if want.ResolutionSpec == nil || len(want.ResolutionSpec.Params) == 0 {
+		if want.ResolutionSpec == nil || want.ResolutionSpec.URL == "" {
+			return nil
+		}
+	}
+	gotParams := make(map[string]pipelinev1.ParamValue)
+	for _, p := range got.ResolutionSpec.Params {
+		gotParams[p.Name] = p.Value
 	}
 
 	var wrongParams []string
-	for _, p := range r.ResolverPayload.ResolutionSpec.Params {
-		if reqValue, ok := reqParams[p.Name]; !ok {
-			wrongParams = append(wrongParams, fmt.Sprintf("expected %s param to be %#v, but was %#v", p.Name, p.Value, reqValue))
-		} else if d := cmp.Diff(p.Value, reqValue); d != "" {
+	for _, p := range want.ResolutionSpec.Params {
+		if gotValue, ok := gotParams[p.Name]; !ok {
+			wrongParams = append(wrongParams, fmt.Sprintf("expected %s param to be %#v, but was %#v", p.Name, p.Value, gotValue))
+		} else if d := cmp.Diff(p.Value, gotValue); d != "" {
 			wrongParams = append(wrongParams, fmt.Sprintf("%s param did not match: %s", p.Name, diff.PrintWantGot(d)))
 		}
 	}
 	if len(wrongParams) > 0 {
-		return nil, errors.New(strings.Join(wrongParams, "; "))
+		return errors.New(strings.Join(wrongParams, "; "))
 	}
-	if r.ResolverPayload.ResolutionSpec.URL != req.ResolverPayload().ResolutionSpec.URL {
-		return nil, fmt.Errorf("Resolution name did not match. Got %s; Want %s", req.ResolverPayload().ResolutionSpec.URL, r.ResolverPayload.ResolutionSpec.URL)
+	if want.ResolutionSpec.URL != got.ResolutionSpec.URL {
+		return fmt.Errorf("Resolution name did not match. Got %s; Want %s", got.ResolutionSpec.URL, want.ResolutionSpec.URL)
 	}
-
-	return r.ResolvedResource, r.SubmitErr
+	return nil
 }
 
 // ResolvedResource implements resolution.ResolvedResource and makes